@@ -13,6 +13,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -66,6 +67,11 @@ type RunServiceDeleteRunParams struct {
 
 	*/
 	ExperimentID *string
+	/*Force
+	  Whether to skip moving the run to trash and hard-delete it immediately.
+
+	*/
+	Force *bool
 	/*RunID
 	  The ID of the run to be deleted.
 
@@ -121,6 +127,17 @@ func (o *RunServiceDeleteRunParams) SetExperimentID(experimentID *string) {
 	o.ExperimentID = experimentID
 }
 
+// WithForce adds the force to the run service delete run params
+func (o *RunServiceDeleteRunParams) WithForce(force *bool) *RunServiceDeleteRunParams {
+	o.SetForce(force)
+	return o
+}
+
+// SetForce adds the force to the run service delete run params
+func (o *RunServiceDeleteRunParams) SetForce(force *bool) {
+	o.Force = force
+}
+
 // WithRunID adds the runID to the run service delete run params
 func (o *RunServiceDeleteRunParams) WithRunID(runID string) *RunServiceDeleteRunParams {
 	o.SetRunID(runID)
@@ -156,6 +173,22 @@ func (o *RunServiceDeleteRunParams) WriteToRequest(r runtime.ClientRequest, reg
 
 	}
 
+	if o.Force != nil {
+
+		// query param force
+		var qrForce bool
+		if o.Force != nil {
+			qrForce = *o.Force
+		}
+		qForce := swag.FormatBool(qrForce)
+		if qForce != "" {
+			if err := r.SetQueryParam("force", qForce); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param run_id
 	if err := r.SetPathParam("run_id", o.RunID); err != nil {
 		return err